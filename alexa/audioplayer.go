@@ -12,6 +12,28 @@ type AudioPlayerRequest struct {
 	OffsetInMilliseconds int    `json:"offsetInMilliseconds"`
 }
 
+// AudioPlayerPlaybackStartedRequest is sent when the requested audio stream has started playing.
+type AudioPlayerPlaybackStartedRequest struct {
+	AudioPlayerRequest
+}
+
+// AudioPlayerPlaybackNearlyFinishedRequest is sent when the currently playing stream is nearly
+// complete, so a skill can enqueue the next stream with AddAudioPlayerPlayDirective.
+type AudioPlayerPlaybackNearlyFinishedRequest struct {
+	AudioPlayerRequest
+}
+
+// AudioPlayerPlaybackFinishedRequest is sent when the requested audio stream has finished playing.
+type AudioPlayerPlaybackFinishedRequest struct {
+	AudioPlayerRequest
+}
+
+// AudioPlayerPlaybackStoppedRequest is sent when the currently playing stream has stopped, either
+// because the user asked Alexa to stop or because a new stream was requested to replace it.
+type AudioPlayerPlaybackStoppedRequest struct {
+	AudioPlayerRequest
+}
+
 // AudioPlayerPlaybackFailedRequest is sent when Alexa encounters an error when attempting to play a stream.
 type AudioPlayerPlaybackFailedRequest struct {
 	AudioPlayerRequest
@@ -26,6 +48,36 @@ type AudioPlayerPlaybackFailedRequest struct {
 	} `json:"currentPlaybackState"`
 }
 
+// PlaybackControllerNextCommandIssuedRequest is sent when the user presses the "next" hardware
+// or on-screen playback button. It carries no payload beyond the common request fields.
+type PlaybackControllerNextCommandIssuedRequest struct {
+	CommonRequest
+}
+
+// PlaybackControllerPreviousCommandIssuedRequest is sent when the user presses the "previous"
+// hardware or on-screen playback button.
+type PlaybackControllerPreviousCommandIssuedRequest struct {
+	CommonRequest
+}
+
+// PlaybackControllerPlayCommandIssuedRequest is sent when the user presses the "play" hardware
+// or on-screen playback button.
+type PlaybackControllerPlayCommandIssuedRequest struct {
+	CommonRequest
+}
+
+// PlaybackControllerPauseCommandIssuedRequest is sent when the user presses the "pause" hardware
+// or on-screen playback button.
+type PlaybackControllerPauseCommandIssuedRequest struct {
+	CommonRequest
+}
+
+// EmptyResponse returns the minimal ResponseEnvelope alexa expects when a skill has nothing to
+// add in reply to an AudioPlayer or PlaybackController event.
+func EmptyResponse() *ResponseEnvelope {
+	return NewResponse()
+}
+
 // AudioPlayerPlayDirective sends Alexa a command to stream the audio file identified by the specified audioItem. Use the playBehavior parameter to determine whether the stream begins playing immediately, or is added to the queue.
 // shouldEndSession should be set to false otherwise playback will pause immediately
 type AudioPlayerPlayDirective struct {
@@ -0,0 +1,62 @@
+package alexa
+
+// DialogDelegateDirective asks alexa to handle the next turn of the conversation itself, using
+// the dialog model defined for the intent, optionally with slot values overridden via UpdatedIntent.
+type DialogDelegateDirective struct {
+	Type          string  `json:"type"`
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"`
+}
+
+// DialogElicitSlotDirective asks alexa to prompt the user for the value of SlotToElicit.
+type DialogElicitSlotDirective struct {
+	Type          string  `json:"type"`
+	SlotToElicit  string  `json:"slotToElicit"`
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"`
+}
+
+// DialogConfirmSlotDirective asks alexa to have the user confirm the value of SlotToConfirm.
+type DialogConfirmSlotDirective struct {
+	Type          string  `json:"type"`
+	SlotToConfirm string  `json:"slotToConfirm"`
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"`
+}
+
+// DialogConfirmIntentDirective asks alexa to have the user confirm the intent as a whole before
+// the skill handles it.
+type DialogConfirmIntentDirective struct {
+	Type          string  `json:"type"`
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"`
+}
+
+// AddDialogDelegateDirective adds a Dialog.Delegate directive, asking alexa to keep driving the
+// conversation using the interaction model's dialog. Pass a non-nil updatedIntent to override
+// slot values collected so far.
+func (r *Response) AddDialogDelegateDirective(updatedIntent *Intent) *DialogDelegateDirective {
+	directive := &DialogDelegateDirective{Type: "Dialog.Delegate", UpdatedIntent: updatedIntent}
+	r.AddDirective(directive)
+	return directive
+}
+
+// AddDialogElicitSlotDirective adds a Dialog.ElicitSlot directive, asking alexa to prompt the
+// user for slotToElicit.
+func (r *Response) AddDialogElicitSlotDirective(slotToElicit string, updatedIntent *Intent) *DialogElicitSlotDirective {
+	directive := &DialogElicitSlotDirective{Type: "Dialog.ElicitSlot", SlotToElicit: slotToElicit, UpdatedIntent: updatedIntent}
+	r.AddDirective(directive)
+	return directive
+}
+
+// AddDialogConfirmSlotDirective adds a Dialog.ConfirmSlot directive, asking alexa to have the
+// user confirm slotToConfirm.
+func (r *Response) AddDialogConfirmSlotDirective(slotToConfirm string, updatedIntent *Intent) *DialogConfirmSlotDirective {
+	directive := &DialogConfirmSlotDirective{Type: "Dialog.ConfirmSlot", SlotToConfirm: slotToConfirm, UpdatedIntent: updatedIntent}
+	r.AddDirective(directive)
+	return directive
+}
+
+// AddDialogConfirmIntentDirective adds a Dialog.ConfirmIntent directive, asking alexa to have
+// the user confirm the whole intent before the skill handles it.
+func (r *Response) AddDialogConfirmIntentDirective(updatedIntent *Intent) *DialogConfirmIntentDirective {
+	directive := &DialogConfirmIntentDirective{Type: "Dialog.ConfirmIntent", UpdatedIntent: updatedIntent}
+	r.AddDirective(directive)
+	return directive
+}
@@ -0,0 +1,212 @@
+package alexa
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+const (
+	signatureCertChainURLHeader = "SignatureCertChainUrl"
+	signatureHeader             = "Signature"
+
+	expectedCertScheme = "https"
+	expectedCertHost   = "s3.amazonaws.com"
+	expectedCertPath   = "/echo.api/"
+	expectedCertSAN    = "echo-api.amazon.com"
+)
+
+// certChain is a downloaded signing certificate together with the intermediates needed to
+// chain it up to a trusted root.
+type certChain struct {
+	leaf          *x509.Certificate
+	intermediates *x509.CertPool
+}
+
+// certChainCache avoids refetching the same SignatureCertChainUrl for every request.
+var certChainCache = struct {
+	sync.Mutex
+	chains map[string]*certChain
+}{chains: make(map[string]*certChain)}
+
+// ServeHTTP implements http.Handler so a Skill can be exposed over plain HTTPS instead of
+// only as an AWS Lambda function. It validates the SignatureCertChainUrl/Signature headers
+// Amazon sends with every request, then hands the decoded envelope to ProcessRequest.
+func (s *Skill) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "alexa: unable to read request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.verifyRequestSignature(r.Header.Get(signatureCertChainURLHeader), r.Header.Get(signatureHeader), body); err != nil {
+		http.Error(w, fmt.Sprintf("alexa: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var requestEnvelope RequestEnvelope
+	if err := json.Unmarshal(body, &requestEnvelope); err != nil {
+		http.Error(w, "alexa: unable to decode request body", http.StatusBadRequest)
+		return
+	}
+
+	responseEnvelope, err := s.ProcessRequest(r.Context(), &requestEnvelope)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("alexa: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	if err := json.NewEncoder(w).Encode(responseEnvelope); err != nil {
+		http.Error(w, "alexa: unable to encode response body", http.StatusInternalServerError)
+	}
+}
+
+// httpClient returns s.HTTPClient, falling back to http.DefaultClient when unset.
+func (s *Skill) httpClient() *http.Client {
+	if s.HTTPClient != nil {
+		return s.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// verifyRequestSignature checks that body was signed by the private key matching the leaf
+// certificate at certChainURL, and that certChainURL itself is one Amazon could plausibly have
+// served the certificate from, per https://developer.amazon.com/docs/custom-skills/host-a-custom-skill-as-a-web-service.html#checking-the-signature-of-the-request
+func (s *Skill) verifyRequestSignature(certChainURL, signature string, body []byte) error {
+	if certChainURL == "" || signature == "" {
+		return errors.New("missing SignatureCertChainUrl or Signature header")
+	}
+	if err := validateCertChainURL(certChainURL); err != nil {
+		return fmt.Errorf("invalid SignatureCertChainUrl: %w", err)
+	}
+
+	chain, err := s.fetchCertificateChain(certChainURL)
+	if err != nil {
+		return fmt.Errorf("unable to obtain signing certificate: %w", err)
+	}
+	return verifySignature(chain, nil, signature, body)
+}
+
+// verifySignature checks that chain.leaf chains up to a trusted root (roots, or the system pool
+// when roots is nil) for expectedCertSAN, and that body was signed by the private key matching
+// chain.leaf. Split out of verifyRequestSignature so tests can supply their own trusted roots
+// instead of depending on the system cert pool.
+func verifySignature(chain *certChain, roots *x509.CertPool, signature string, body []byte) error {
+	cert := chain.leaf
+	if _, err := cert.Verify(x509.VerifyOptions{DNSName: expectedCertSAN, Intermediates: chain.intermediates, Roots: roots}); err != nil {
+		return fmt.Errorf("signing certificate does not chain to a trusted root for %s: %w", expectedCertSAN, err)
+	}
+
+	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return errors.New("signing certificate does not use an RSA public key")
+	}
+
+	decodedSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("unable to decode Signature header: %w", err)
+	}
+
+	hashed := sha1.Sum(body)
+	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA1, hashed[:], decodedSignature); err != nil {
+		return fmt.Errorf("signature does not match request body: %w", err)
+	}
+	return nil
+}
+
+// validateCertChainURL enforces Amazon's whitelist for where the signing certificate may be hosted.
+func validateCertChainURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(parsed.Scheme, expectedCertScheme) {
+		return fmt.Errorf("scheme must be %q, got %q", expectedCertScheme, parsed.Scheme)
+	}
+	if !strings.EqualFold(parsed.Hostname(), expectedCertHost) {
+		return fmt.Errorf("host must be %q, got %q", expectedCertHost, parsed.Hostname())
+	}
+	if port := parsed.Port(); port != "" && port != "443" {
+		return fmt.Errorf("port must be 443, got %q", port)
+	}
+	// Canonicalize the path before checking it: neither url.Parse nor the HTTP client normalizes
+	// ".." segments, so an unnormalized path like "/echo.api/../../attacker-bucket/cert.pem" would otherwise
+	// pass the prefix check verbatim and be routed elsewhere by a path-normalizing origin.
+	cleanPath := path.Clean(parsed.Path)
+	if cleanPath != "/" {
+		cleanPath += "/"
+	}
+	if !strings.HasPrefix(cleanPath, expectedCertPath) {
+		return fmt.Errorf("path must start with %q, got %q", expectedCertPath, parsed.Path)
+	}
+	return nil
+}
+
+// fetchCertificateChain downloads and parses the certificate chain at certChainURL, caching it
+// for subsequent requests that reuse the same URL. The first certificate in the PEM bundle is
+// taken as the leaf; the rest are kept as intermediates so the leaf can be verified up to a
+// trusted root instead of being trusted in isolation.
+func (s *Skill) fetchCertificateChain(certChainURL string) (*certChain, error) {
+	certChainCache.Lock()
+	if chain, ok := certChainCache.chains[certChainURL]; ok {
+		certChainCache.Unlock()
+		return chain, nil
+	}
+	certChainCache.Unlock()
+
+	resp, err := s.httpClient().Get(certChainURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching certificate chain: %s", resp.Status)
+	}
+	pemBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var leaf *x509.Certificate
+	intermediates := x509.NewCertPool()
+	rest := pemBytes
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse certificate: %w", err)
+		}
+		if leaf == nil {
+			leaf = cert
+		} else {
+			intermediates.AddCert(cert)
+		}
+	}
+	if leaf == nil {
+		return nil, errors.New("certificate chain did not contain any certificates")
+	}
+	chain := &certChain{leaf: leaf, intermediates: intermediates}
+
+	certChainCache.Lock()
+	certChainCache.chains[certChainURL] = chain
+	certChainCache.Unlock()
+
+	return chain, nil
+}
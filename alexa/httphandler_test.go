@@ -0,0 +1,141 @@
+package alexa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestValidateCertChainURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{"valid", "https://s3.amazonaws.com/echo.api/echo-api-cert-4.pem", false},
+		{"valid with explicit default port", "https://s3.amazonaws.com:443/echo.api/echo-api-cert-4.pem", false},
+		{"wrong scheme", "http://s3.amazonaws.com/echo.api/echo-api-cert-4.pem", true},
+		{"wrong host", "https://s3.amazonaws.com.attacker.com/echo.api/echo-api-cert-4.pem", true},
+		{"wrong port", "https://s3.amazonaws.com:8443/echo.api/echo-api-cert-4.pem", true},
+		{"missing path prefix", "https://s3.amazonaws.com/not-echo-api/echo-api-cert-4.pem", true},
+		{"path traversal escaping echo.api", "https://s3.amazonaws.com/echo.api/../../attacker-bucket/cert.pem", true},
+		{"path traversal back into echo.api", "https://s3.amazonaws.com/anything/../echo.api/echo-api-cert-4.pem", false},
+		{"malformed url", "https://s3.amazonaws.com/echo.api/%zz", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCertChainURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateCertChainURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// generateTestCert creates a self-signed certificate valid for expectedCertSAN, returned both
+// PEM-encoded (as Amazon would serve it) and parsed, along with its private key.
+func generateTestCert(t *testing.T) (pemBytes []byte, cert *x509.Certificate, key *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: expectedCertSAN},
+		DNSNames:              []string{expectedCertSAN},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate: %v", err)
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate: %v", err)
+	}
+	pemBytes = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return pemBytes, cert, key
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper, so tests can fake the certificate
+// download fetchCertificateChain performs without a real network call.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func fakeClientServing(body []byte) *http.Client {
+	return &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(body)),
+			Header:     make(http.Header),
+		}, nil
+	})}
+}
+
+func TestFetchCertificateChain(t *testing.T) {
+	pemBytes, cert, _ := generateTestCert(t)
+	skill := &Skill{HTTPClient: fakeClientServing(pemBytes)}
+
+	chain, err := skill.fetchCertificateChain("https://example.invalid/cert.pem")
+	if err != nil {
+		t.Fatalf("fetchCertificateChain: %v", err)
+	}
+	if !chain.leaf.Equal(cert) {
+		t.Fatal("fetchCertificateChain returned a different leaf certificate than was served")
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	_, cert, key := generateTestCert(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	chain := &certChain{leaf: cert, intermediates: x509.NewCertPool()}
+
+	body := []byte(`{"request":"body"}`)
+	hashed := sha1.Sum(body)
+	signatureBytes, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, hashed[:])
+	if err != nil {
+		t.Fatalf("rsa.SignPKCS1v15: %v", err)
+	}
+	signature := base64.StdEncoding.EncodeToString(signatureBytes)
+
+	if err := verifySignature(chain, roots, signature, body); err != nil {
+		t.Fatalf("verifySignature rejected a correctly signed body: %v", err)
+	}
+
+	t.Run("rejects untrusted root", func(t *testing.T) {
+		if err := verifySignature(chain, x509.NewCertPool(), signature, body); err == nil {
+			t.Fatal("verifySignature accepted a certificate that does not chain to a trusted root")
+		}
+	})
+
+	t.Run("rejects forged signature", func(t *testing.T) {
+		forged := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{0x00}, len(signatureBytes)))
+		if err := verifySignature(chain, roots, forged, body); err == nil {
+			t.Fatal("verifySignature accepted a forged signature")
+		}
+	})
+
+	t.Run("rejects tampered body", func(t *testing.T) {
+		if err := verifySignature(chain, roots, signature, append(body, '!')); err == nil {
+			t.Fatal("verifySignature accepted a body that does not match the signature")
+		}
+	})
+}
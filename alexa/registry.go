@@ -0,0 +1,72 @@
+package alexa
+
+import (
+	"fmt"
+	"sync"
+)
+
+// requestTypeRegistry maps a request's "type" field to a factory producing the concrete struct
+// TypedRequest should decode it into. Populated for the built-in request types in this package's
+// init, and extendable via RegisterRequestType. Guarded by requestTypeRegistryMu since
+// RegisterRequestType may be called concurrently with in-flight ProcessRequest/ServeHTTP calls.
+var (
+	requestTypeRegistryMu sync.RWMutex
+	requestTypeRegistry   = map[string]func() RequestEnvelopeDataProvider{}
+)
+
+func init() {
+	RegisterRequestType("LaunchRequest", func() RequestEnvelopeDataProvider { return &LaunchRequest{} })
+	RegisterRequestType("IntentRequest", func() RequestEnvelopeDataProvider { return &IntentRequest{} })
+	RegisterRequestType("SessionEndedRequest", func() RequestEnvelopeDataProvider { return &SessionEndedRequest{} })
+	RegisterRequestType("System.ExceptionEncountered", func() RequestEnvelopeDataProvider { return &SystemExceptionEncounteredRequest{} })
+
+	RegisterRequestType("AudioPlayer.PlaybackStarted", func() RequestEnvelopeDataProvider { return &AudioPlayerPlaybackStartedRequest{} })
+	RegisterRequestType("AudioPlayer.PlaybackNearlyFinished", func() RequestEnvelopeDataProvider { return &AudioPlayerPlaybackNearlyFinishedRequest{} })
+	RegisterRequestType("AudioPlayer.PlaybackFinished", func() RequestEnvelopeDataProvider { return &AudioPlayerPlaybackFinishedRequest{} })
+	RegisterRequestType("AudioPlayer.PlaybackStopped", func() RequestEnvelopeDataProvider { return &AudioPlayerPlaybackStoppedRequest{} })
+	RegisterRequestType("AudioPlayer.PlaybackFailed", func() RequestEnvelopeDataProvider { return &AudioPlayerPlaybackFailedRequest{} })
+
+	RegisterRequestType("PlaybackController.NextCommandIssued", func() RequestEnvelopeDataProvider { return &PlaybackControllerNextCommandIssuedRequest{} })
+	RegisterRequestType("PlaybackController.PreviousCommandIssued", func() RequestEnvelopeDataProvider { return &PlaybackControllerPreviousCommandIssuedRequest{} })
+	RegisterRequestType("PlaybackController.PlayCommandIssued", func() RequestEnvelopeDataProvider { return &PlaybackControllerPlayCommandIssuedRequest{} })
+	RegisterRequestType("PlaybackController.PauseCommandIssued", func() RequestEnvelopeDataProvider { return &PlaybackControllerPauseCommandIssuedRequest{} })
+}
+
+// RegisterRequestType registers factory as the constructor TypedRequest and Skill.ProcessRequest
+// use to decode requests whose "type" field equals typeName. Third parties can use this to add
+// support for request types this package doesn't know about yet (e.g. Display.ElementSelected,
+// GameEngine.InputHandlerEvent) without forking it. factory's returned value must embed
+// CommonRequest so it satisfies RequestEnvelopeDataProvider. Registering a typeName a second time
+// replaces its factory. When factory returns a struct type other than this package's built-in
+// request types, Skill.ProcessRequest decodes the request but then returns an
+// unsupported-request-type error, since RequestHandler has no method for it.
+func RegisterRequestType(typeName string, factory func() RequestEnvelopeDataProvider) {
+	requestTypeRegistryMu.Lock()
+	defer requestTypeRegistryMu.Unlock()
+	requestTypeRegistry[typeName] = factory
+}
+
+// TypedRequest returns requestEnvelope.Request decoded into the concrete struct registered for
+// its "type" field (see RegisterRequestType), with Session and Context already populated. It
+// returns an error if the type is not registered, or the request could not be decoded into it.
+func (requestEnvelope *RequestEnvelope) TypedRequest() (interface{}, error) {
+	rawRequest, ok := requestEnvelope.Request.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("alexa: request has no %q field", "type")
+	}
+	typeName, ok := rawRequest["type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("alexa: request has no %q field", "type")
+	}
+	requestTypeRegistryMu.RLock()
+	factory, ok := requestTypeRegistry[typeName]
+	requestTypeRegistryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("alexa: unsupported request type %q", typeName)
+	}
+	requestObj := factory()
+	if err := requestEnvelope.getTypedRequest(requestObj); err != nil {
+		return nil, fmt.Errorf("alexa: unable to decode request type %q: %w", typeName, err)
+	}
+	return requestObj, nil
+}
@@ -0,0 +1,41 @@
+package alexa_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexa"
+)
+
+// TestRegisterRequestTypeConcurrentWithTypedRequest exercises the scenario the requestTypeRegistry
+// doc comment advertises: a third party calling RegisterRequestType while requests are already
+// being decoded. Run with -race to catch regressions to requestTypeRegistry's locking.
+func TestRegisterRequestTypeConcurrentWithTypedRequest(t *testing.T) {
+	const typeName = "Test.ConcurrentRegistration"
+	request := alexatestLaunchRequestWithType(typeName)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		alexa.RegisterRequestType(typeName, func() alexa.RequestEnvelopeDataProvider { return &alexa.LaunchRequest{} })
+	}()
+	go func() {
+		defer wg.Done()
+		request.TypedRequest()
+	}()
+	wg.Wait()
+}
+
+// alexatestLaunchRequestWithType builds a minimal RequestEnvelope carrying a request of the
+// given type, without depending on the alexatest package (which only knows the built-in types).
+func alexatestLaunchRequestWithType(typeName string) *alexa.RequestEnvelope {
+	return &alexa.RequestEnvelope{
+		Request: map[string]interface{}{
+			"type":      typeName,
+			"requestId": "amzn1.echo-api.request.test-request-id",
+			"timestamp": "2026-07-29T00:00:00Z",
+			"locale":    "en-US",
+		},
+	}
+}
@@ -63,8 +63,10 @@ type AudioPlayer struct {
 	PlayerActivity       string `json:"playerActivity"`
 }
 
-// requestEnvelopeDataProvider provides a way to set Context and Session metadata for common requests.
-type requestEnvelopeDataProvider interface {
+// RequestEnvelopeDataProvider provides a way to set Context and Session metadata for common
+// requests. Every request struct satisfies it by embedding CommonRequest; third-party request
+// structs registered via RegisterRequestType get it the same way.
+type RequestEnvelopeDataProvider interface {
 	setContext(ctx *Context)
 	setSession(session *Session)
 }
@@ -101,10 +103,10 @@ type Intent struct {
 
 // IntentSlot is provided in Intents
 type IntentSlot struct {
-	Name               string      `json:"name"`
-	Value              string      `json:"value"`
-	ConfirmationStatus string      `json:"confirmationStatus,omitempty"`
-	Resolutions        interface{} `json:"resolutions"`
+	Name               string       `json:"name"`
+	Value              string       `json:"value"`
+	ConfirmationStatus string       `json:"confirmationStatus,omitempty"`
+	Resolutions        *Resolutions `json:"resolutions,omitempty"`
 }
 
 // SessionEndedRequest if a skill is stopped or cancelled.
@@ -132,11 +134,21 @@ type SystemExceptionEncounteredRequest struct {
 // GetTypedRequest provides the request object mapped to the given struct
 func (requestEnvelope *RequestEnvelope) getTypedRequest(requestObj interface{}) error {
 	data, _ := json.Marshal(requestEnvelope.Request)
-	requestObj.(requestEnvelopeDataProvider).setContext(&requestEnvelope.Context)
-	requestObj.(requestEnvelopeDataProvider).setSession(&requestEnvelope.Session)
+	requestObj.(RequestEnvelopeDataProvider).setContext(&requestEnvelope.Context)
+	requestObj.(RequestEnvelopeDataProvider).setSession(&requestEnvelope.Session)
 	return json.Unmarshal(data, &requestObj)
 }
 
+// applicationID returns the ApplicationID alexa sent for this request. AudioPlayer and
+// PlaybackController requests have no session context, so Session.Application.ApplicationID is
+// always empty for them; Context.System.Application.ApplicationID is populated instead.
+func (requestEnvelope *RequestEnvelope) applicationID() string {
+	if id := requestEnvelope.Session.Application.ApplicationID; id != "" {
+		return id
+	}
+	return requestEnvelope.Context.System.Application.ApplicationID
+}
+
 func (cr *CommonRequest) setContext(ctx *Context) {
 	cr.Context = ctx
 }
@@ -144,16 +156,14 @@ func (cr *CommonRequest) setSession(session *Session) {
 	cr.Session = session
 }
 
-// VerifyTimestamp checks if the the timestamp is not older than 30 seconds
-func (requestEnvelope *RequestEnvelope) verifyTimestamp() bool {
-	timestampStr := requestEnvelope.Request.(map[string]interface{})["timestamp"].(string)
-
-	requestTimestamp, err := time.Parse("2006-01-02T15:04:05Z", timestampStr)
+// verifyTimestamp checks that the given request timestamp is not older than 30 seconds.
+// It takes the Timestamp from the already-decoded CommonRequest and does not read
+// RequestEnvelope.Request, so it makes no assumption about Request's dynamic type.
+func verifyTimestamp(timestamp string) bool {
+	requestTimestamp, err := time.Parse("2006-01-02T15:04:05Z", timestamp)
 	if err != nil {
-		log.Println("Error parsing request timestamp with value ", timestampStr, requestEnvelope.Request)
-	}
-	if time.Since(requestTimestamp).Seconds() < (time.Duration(30) * time.Second).Seconds() {
-		return true
+		log.Println("Error parsing request timestamp with value ", timestamp)
+		return false
 	}
-	return false
+	return time.Since(requestTimestamp).Seconds() < (time.Duration(30) * time.Second).Seconds()
 }
@@ -0,0 +1,64 @@
+package alexa
+
+// Resolution status codes alexa reports for a slot's entity resolution against an authority.
+const (
+	ResolutionStatusMatch   = "ER_SUCCESS_MATCH"
+	ResolutionStatusNoMatch = "ER_SUCCESS_NO_MATCH"
+)
+
+// Resolutions holds the entity resolution results for a slot, one entry per authority (custom
+// slot type or built-in catalog) the interaction model configured for it.
+type Resolutions struct {
+	ResolutionsPerAuthority []Resolution `json:"resolutionsPerAuthority"`
+}
+
+// Resolution is a single authority's attempt to resolve the raw slot value the user spoke
+// against its catalog of synonyms.
+type Resolution struct {
+	Authority Authority      `json:"authority"`
+	Status    Status         `json:"status"`
+	Values    []ValueWrapper `json:"values,omitempty"`
+}
+
+// Authority identifies the slot type or catalog a Resolution was resolved against.
+type Authority string
+
+// Status reports whether a Resolution matched the user's utterance, and if not why.
+type Status struct {
+	Code string `json:"code"`
+}
+
+// ValueWrapper wraps a single resolved Value, matching the {"value": {...}} shape alexa sends.
+type ValueWrapper struct {
+	Value Value `json:"value"`
+}
+
+// Value is one resolved slot value candidate, e.g. the canonical name and id of a catalog entry.
+type Value struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// FirstResolvedValue returns the id and name of the first ER_SUCCESS_MATCH resolution found for
+// this slot across all queried authorities, and whether a match was found at all.
+func (s *IntentSlot) FirstResolvedValue() (id, name string, matched bool) {
+	if s.Resolutions == nil {
+		return "", "", false
+	}
+	for _, resolution := range s.Resolutions.ResolutionsPerAuthority {
+		if resolution.Status.Code != ResolutionStatusMatch || len(resolution.Values) == 0 {
+			continue
+		}
+		return resolution.Values[0].Value.ID, resolution.Values[0].Value.Name, true
+	}
+	return "", "", false
+}
+
+// ResolutionStatus returns the status code of this slot's first resolution entry, or an empty
+// string if the slot has no resolutions at all (e.g. it isn't backed by a slot type with synonyms).
+func (s *IntentSlot) ResolutionStatus() string {
+	if s.Resolutions == nil || len(s.Resolutions.ResolutionsPerAuthority) == 0 {
+		return ""
+	}
+	return s.Resolutions.ResolutionsPerAuthority[0].Status.Code
+}
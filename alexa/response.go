@@ -0,0 +1,116 @@
+package alexa
+
+// ResponseEnvelope is the data structure a skill must reply with in response to a request.
+type ResponseEnvelope struct {
+	Version           string                 `json:"version"`
+	SessionAttributes map[string]interface{} `json:"sessionAttributes,omitempty"`
+	Response          *Response              `json:"response"`
+}
+
+// Response carries the speech, card and directives alexa uses to reply to the user.
+type Response struct {
+	OutputSpeech     *OutputSpeech `json:"outputSpeech,omitempty"`
+	Card             *Card         `json:"card,omitempty"`
+	Reprompt         *Reprompt     `json:"reprompt,omitempty"`
+	Directives       []interface{} `json:"directives,omitempty"`
+	ShouldEndSession *bool         `json:"shouldEndSession,omitempty"`
+}
+
+// OutputSpeech is either plain text or SSML spoken back to the user.
+type OutputSpeech struct {
+	Type string `json:"type"`
+	Text string `json:"text,omitempty"`
+	SSML string `json:"ssml,omitempty"`
+}
+
+// Card is rendered in the Alexa companion app.
+type Card struct {
+	Type    string     `json:"type"`
+	Title   string     `json:"title,omitempty"`
+	Content string     `json:"content,omitempty"`
+	Text    string     `json:"text,omitempty"`
+	Image   *CardImage `json:"image,omitempty"`
+}
+
+// CardImage provides the image urls for a Standard card.
+type CardImage struct {
+	SmallImageURL string `json:"smallImageUrl,omitempty"`
+	LargeImageURL string `json:"largeImageUrl,omitempty"`
+}
+
+// Reprompt is spoken if the user does not respond to the initial output speech.
+type Reprompt struct {
+	OutputSpeech *OutputSpeech `json:"outputSpeech"`
+}
+
+// DisplayImageObject describes an image that may be rendered on Alexa-enabled devices with a screen.
+type DisplayImageObject struct {
+	ContentDescription string               `json:"contentDescription,omitempty"`
+	Sources            []DisplayImageSource `json:"sources,omitempty"`
+}
+
+// DisplayImageSource is a single rendition of a DisplayImageObject at a given size.
+type DisplayImageSource struct {
+	URL          string `json:"url"`
+	Size         string `json:"size,omitempty"`
+	WidthPixels  int    `json:"widthPixels,omitempty"`
+	HeightPixels int    `json:"heightPixels,omitempty"`
+}
+
+// AddSource appends an image rendition to the image object and returns it for chaining.
+func (i *DisplayImageObject) AddSource(url string, widthPixels, heightPixels int) *DisplayImageObject {
+	i.Sources = append(i.Sources, DisplayImageSource{URL: url, WidthPixels: widthPixels, HeightPixels: heightPixels})
+	return i
+}
+
+// NewResponse creates an empty ResponseEnvelope ready to be decorated by a skill.
+func NewResponse() *ResponseEnvelope {
+	return &ResponseEnvelope{
+		Version:  "1.0",
+		Response: &Response{},
+	}
+}
+
+// SetOutputSpeech sets plain text output speech on the response.
+func (re *ResponseEnvelope) SetOutputSpeech(text string) *ResponseEnvelope {
+	re.Response.OutputSpeech = &OutputSpeech{Type: "PlainText", Text: text}
+	return re
+}
+
+// SetOutputSSML sets SSML output speech on the response.
+func (re *ResponseEnvelope) SetOutputSSML(ssml string) *ResponseEnvelope {
+	re.Response.OutputSpeech = &OutputSpeech{Type: "SSML", SSML: ssml}
+	return re
+}
+
+// SetReprompt sets plain text reprompt speech on the response.
+func (re *ResponseEnvelope) SetReprompt(text string) *ResponseEnvelope {
+	re.Response.Reprompt = &Reprompt{OutputSpeech: &OutputSpeech{Type: "PlainText", Text: text}}
+	return re
+}
+
+// SetSimpleCard attaches a Simple card with the given title and content to the response.
+func (re *ResponseEnvelope) SetSimpleCard(title, content string) *ResponseEnvelope {
+	re.Response.Card = &Card{Type: "Simple", Title: title, Content: content}
+	return re
+}
+
+// SetShouldEndSession marks whether the session should end after this response.
+func (re *ResponseEnvelope) SetShouldEndSession(end bool) *ResponseEnvelope {
+	re.Response.ShouldEndSession = &end
+	return re
+}
+
+// SetSessionAttribute stores a value in the session attributes returned to alexa.
+func (re *ResponseEnvelope) SetSessionAttribute(key string, value interface{}) *ResponseEnvelope {
+	if re.SessionAttributes == nil {
+		re.SessionAttributes = make(map[string]interface{})
+	}
+	re.SessionAttributes[key] = value
+	return re
+}
+
+// AddDirective appends a directive to the response.
+func (r *Response) AddDirective(directive interface{}) {
+	r.Directives = append(r.Directives, directive)
+}
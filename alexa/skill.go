@@ -0,0 +1,128 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// RequestHandler must be implemented by a skill in order to react to the requests
+// alexa can send it. Skill.ProcessRequest dispatches to the method matching the
+// decoded request's type.
+type RequestHandler interface {
+	OnLaunch(ctx context.Context, request *LaunchRequest) (*ResponseEnvelope, error)
+	OnIntent(ctx context.Context, request *IntentRequest) (*ResponseEnvelope, error)
+	OnSessionEnded(ctx context.Context, request *SessionEndedRequest) (*ResponseEnvelope, error)
+
+	OnAudioPlayerPlaybackStarted(ctx context.Context, request *AudioPlayerPlaybackStartedRequest) (*ResponseEnvelope, error)
+	OnAudioPlayerPlaybackNearlyFinished(ctx context.Context, request *AudioPlayerPlaybackNearlyFinishedRequest) (*ResponseEnvelope, error)
+	OnAudioPlayerPlaybackFinished(ctx context.Context, request *AudioPlayerPlaybackFinishedRequest) (*ResponseEnvelope, error)
+	OnAudioPlayerPlaybackStopped(ctx context.Context, request *AudioPlayerPlaybackStoppedRequest) (*ResponseEnvelope, error)
+	OnAudioPlayerPlaybackFailed(ctx context.Context, request *AudioPlayerPlaybackFailedRequest) (*ResponseEnvelope, error)
+
+	OnPlaybackControllerNextCommandIssued(ctx context.Context, request *PlaybackControllerNextCommandIssuedRequest) (*ResponseEnvelope, error)
+	OnPlaybackControllerPreviousCommandIssued(ctx context.Context, request *PlaybackControllerPreviousCommandIssuedRequest) (*ResponseEnvelope, error)
+	OnPlaybackControllerPlayCommandIssued(ctx context.Context, request *PlaybackControllerPlayCommandIssuedRequest) (*ResponseEnvelope, error)
+	OnPlaybackControllerPauseCommandIssued(ctx context.Context, request *PlaybackControllerPauseCommandIssuedRequest) (*ResponseEnvelope, error)
+
+	OnSystemExceptionEncountered(ctx context.Context, request *SystemExceptionEncounteredRequest) (*ResponseEnvelope, error)
+}
+
+// Skill wires a RequestHandler to the security checks alexa expects from every
+// skill: the configured ApplicationID must match the one in the request, and the
+// request must be recent enough to rule out replay attacks.
+type Skill struct {
+	// ApplicationID is the skill id alexa puts in Session.Application.ApplicationID
+	// and Context.System.Application.ApplicationID. Requests for any other id are rejected
+	// unless IgnoreApplicationID is set.
+	ApplicationID string
+	// IgnoreApplicationID disables the ApplicationID check. Useful in tests.
+	IgnoreApplicationID bool
+	// IgnoreTimestamp disables the request timestamp freshness check. Useful in tests.
+	IgnoreTimestamp bool
+	// Handler receives the decoded, typed requests.
+	Handler RequestHandler
+	// HTTPClient is used by ServeHTTP to fetch the SignatureCertChainUrl. Defaults to
+	// http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+// ProcessRequest validates requestEnvelope and dispatches it to the matching method on
+// s.Handler, returning whatever ResponseEnvelope (or error) the handler produces.
+func (s *Skill) ProcessRequest(ctx context.Context, requestEnvelope *RequestEnvelope) (*ResponseEnvelope, error) {
+	if !s.IgnoreApplicationID {
+		if applicationID := requestEnvelope.applicationID(); applicationID != s.ApplicationID {
+			return nil, fmt.Errorf("alexa: application id %q does not match configured application id", applicationID)
+		}
+	}
+
+	typed, err := requestEnvelope.TypedRequest()
+	if err != nil {
+		return nil, err
+	}
+	if common, ok := typed.(commonRequest); ok && !s.IgnoreTimestamp && !verifyTimestamp(common.timestamp()) {
+		return nil, errors.New("alexa: request timestamp is too old")
+	}
+
+	switch request := typed.(type) {
+	case *LaunchRequest:
+		return s.Handler.OnLaunch(ctx, request)
+	case *IntentRequest:
+		return s.Handler.OnIntent(ctx, request)
+	case *SessionEndedRequest:
+		return s.Handler.OnSessionEnded(ctx, request)
+	case *AudioPlayerPlaybackStartedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnAudioPlayerPlaybackStarted(ctx, request))
+	case *AudioPlayerPlaybackNearlyFinishedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnAudioPlayerPlaybackNearlyFinished(ctx, request))
+	case *AudioPlayerPlaybackFinishedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnAudioPlayerPlaybackFinished(ctx, request))
+	case *AudioPlayerPlaybackStoppedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnAudioPlayerPlaybackStopped(ctx, request))
+	case *AudioPlayerPlaybackFailedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnAudioPlayerPlaybackFailed(ctx, request))
+	case *PlaybackControllerNextCommandIssuedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnPlaybackControllerNextCommandIssued(ctx, request))
+	case *PlaybackControllerPreviousCommandIssuedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnPlaybackControllerPreviousCommandIssued(ctx, request))
+	case *PlaybackControllerPlayCommandIssuedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnPlaybackControllerPlayCommandIssued(ctx, request))
+	case *PlaybackControllerPauseCommandIssuedRequest:
+		return requireAudioPlayerOnlyResponse(s.Handler.OnPlaybackControllerPauseCommandIssued(ctx, request))
+	case *SystemExceptionEncounteredRequest:
+		return s.Handler.OnSystemExceptionEncountered(ctx, request)
+	default:
+		return nil, fmt.Errorf("alexa: unsupported request type %T", request)
+	}
+}
+
+// commonRequest is implemented by every concrete request struct through the embedded CommonRequest.
+type commonRequest interface {
+	timestamp() string
+}
+
+func (cr *CommonRequest) timestamp() string {
+	return cr.Timestamp
+}
+
+// requireAudioPlayerOnlyResponse rejects responses to AudioPlayer and PlaybackController requests
+// that contain outputSpeech, a card or a reprompt, since the spec only allows those requests to be
+// answered with AudioPlayer directives (or nothing at all).
+func requireAudioPlayerOnlyResponse(response *ResponseEnvelope, err error) (*ResponseEnvelope, error) {
+	if err != nil {
+		return nil, err
+	}
+	if response == nil || response.Response == nil {
+		return response, nil
+	}
+	switch {
+	case response.Response.OutputSpeech != nil:
+		return nil, errors.New("alexa: responses to AudioPlayer and PlaybackController requests must not include outputSpeech")
+	case response.Response.Card != nil:
+		return nil, errors.New("alexa: responses to AudioPlayer and PlaybackController requests must not include a card")
+	case response.Response.Reprompt != nil:
+		return nil, errors.New("alexa: responses to AudioPlayer and PlaybackController requests must not include a reprompt")
+	}
+	return response, nil
+}
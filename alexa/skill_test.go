@@ -0,0 +1,149 @@
+package alexa_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexa"
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexatest"
+)
+
+// stubHandler implements alexa.RequestHandler, returning canned responses and recording the
+// last request it was handed so tests can assert on what Skill.ProcessRequest dispatched.
+type stubHandler struct {
+	launchResponse *alexa.ResponseEnvelope
+	intentResponse *alexa.ResponseEnvelope
+	lastIntent     *alexa.IntentRequest
+}
+
+func (h *stubHandler) OnLaunch(ctx context.Context, request *alexa.LaunchRequest) (*alexa.ResponseEnvelope, error) {
+	return h.launchResponse, nil
+}
+
+func (h *stubHandler) OnIntent(ctx context.Context, request *alexa.IntentRequest) (*alexa.ResponseEnvelope, error) {
+	h.lastIntent = request
+	return h.intentResponse, nil
+}
+
+func (h *stubHandler) OnSessionEnded(ctx context.Context, request *alexa.SessionEndedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.NewResponse(), nil
+}
+
+func (h *stubHandler) OnAudioPlayerPlaybackStarted(ctx context.Context, request *alexa.AudioPlayerPlaybackStartedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnAudioPlayerPlaybackNearlyFinished(ctx context.Context, request *alexa.AudioPlayerPlaybackNearlyFinishedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnAudioPlayerPlaybackFinished(ctx context.Context, request *alexa.AudioPlayerPlaybackFinishedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnAudioPlayerPlaybackStopped(ctx context.Context, request *alexa.AudioPlayerPlaybackStoppedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnAudioPlayerPlaybackFailed(ctx context.Context, request *alexa.AudioPlayerPlaybackFailedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnPlaybackControllerNextCommandIssued(ctx context.Context, request *alexa.PlaybackControllerNextCommandIssuedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnPlaybackControllerPreviousCommandIssued(ctx context.Context, request *alexa.PlaybackControllerPreviousCommandIssuedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnPlaybackControllerPlayCommandIssued(ctx context.Context, request *alexa.PlaybackControllerPlayCommandIssuedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnPlaybackControllerPauseCommandIssued(ctx context.Context, request *alexa.PlaybackControllerPauseCommandIssuedRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.EmptyResponse(), nil
+}
+
+func (h *stubHandler) OnSystemExceptionEncountered(ctx context.Context, request *alexa.SystemExceptionEncounteredRequest) (*alexa.ResponseEnvelope, error) {
+	return alexa.NewResponse(), nil
+}
+
+func TestProcessRequest_Launch(t *testing.T) {
+	handler := &stubHandler{launchResponse: alexa.NewResponse().SetOutputSpeech("welcome back")}
+	skill := &alexa.Skill{ApplicationID: "amzn1.ask.skill.test-application-id", Handler: handler}
+
+	resp, err := skill.ProcessRequest(context.Background(), alexatest.NewLaunchRequest("en-US"))
+	if err != nil {
+		t.Fatalf("ProcessRequest returned error: %v", err)
+	}
+	alexatest.AssertSpeech(t, resp, "welcome back")
+}
+
+func TestProcessRequest_IntentWithResolvedSlot(t *testing.T) {
+	handler := &stubHandler{}
+	handler.intentResponse = alexa.NewResponse()
+	skill := &alexa.Skill{ApplicationID: "amzn1.ask.skill.test-application-id", Handler: handler}
+
+	request := alexatest.NewIntentRequest("GetCityIntent").
+		WithSlot("city", "seattle").
+		WithResolution("AMAZON.US_CITY", alexa.ResolutionStatusMatch, "CITY_SEA", "Seattle")
+
+	if _, err := skill.ProcessRequest(context.Background(), request.RequestEnvelope); err != nil {
+		t.Fatalf("ProcessRequest returned error: %v", err)
+	}
+
+	slot := handler.lastIntent.Intent.Slots["city"]
+	if id, name, matched := slot.FirstResolvedValue(); !matched || id != "CITY_SEA" || name != "Seattle" {
+		t.Fatalf("FirstResolvedValue() = (%q, %q, %v), want (%q, %q, true)", id, name, matched, "CITY_SEA", "Seattle")
+	}
+}
+
+func TestProcessRequest_AudioPlayerEvent(t *testing.T) {
+	handler := &stubHandler{}
+	skill := &alexa.Skill{ApplicationID: "amzn1.ask.skill.test-application-id", Handler: handler}
+
+	request := alexatest.NewAudioPlayerRequest("AudioPlayer.PlaybackStarted", "stream-token", 1000)
+	resp, err := skill.ProcessRequest(context.Background(), request)
+	if err != nil {
+		t.Fatalf("ProcessRequest returned error: %v", err)
+	}
+	if resp == nil || resp.Response == nil {
+		t.Fatalf("ProcessRequest returned no response")
+	}
+}
+
+func TestProcessRequest_RejectsMismatchedApplicationID(t *testing.T) {
+	handler := &stubHandler{}
+	skill := &alexa.Skill{ApplicationID: "amzn1.ask.skill.configured-id", Handler: handler}
+
+	request := alexatest.NewLaunchRequest("en-US")
+	if _, err := skill.ProcessRequest(context.Background(), request); err == nil {
+		t.Fatal("ProcessRequest did not reject a request for a different application id")
+	}
+}
+
+func TestProcessRequest_ChecksApplicationIDAgainstContextWhenSessionIsAbsent(t *testing.T) {
+	handler := &stubHandler{}
+	skill := &alexa.Skill{ApplicationID: "amzn1.ask.skill.test-application-id", Handler: handler}
+
+	request := alexatest.NewAudioPlayerRequest("AudioPlayer.PlaybackStarted", "stream-token", 0)
+	request.Session.Application.ApplicationID = ""
+
+	if _, err := skill.ProcessRequest(context.Background(), request); err != nil {
+		t.Fatalf("ProcessRequest rejected a request whose application id is only set on Context.System: %v", err)
+	}
+}
+
+func TestProcessRequest_RejectsStaleTimestamp(t *testing.T) {
+	handler := &stubHandler{}
+	skill := &alexa.Skill{ApplicationID: "amzn1.ask.skill.test-application-id", Handler: handler}
+
+	request := alexatest.NewLaunchRequest("en-US")
+	rawRequest := request.Request.(map[string]interface{})
+	rawRequest["timestamp"] = "2000-01-01T00:00:00Z"
+
+	if _, err := skill.ProcessRequest(context.Background(), request); err == nil {
+		t.Fatal("ProcessRequest did not reject a stale request timestamp")
+	}
+}
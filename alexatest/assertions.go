@@ -0,0 +1,84 @@
+package alexatest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexa"
+)
+
+// AssertSpeech fails the test unless resp's output speech (plain text or SSML) equals want.
+func AssertSpeech(t *testing.T, resp *alexa.ResponseEnvelope, want string) {
+	t.Helper()
+	if resp == nil || resp.Response == nil || resp.Response.OutputSpeech == nil {
+		t.Fatalf("alexatest: response has no outputSpeech, want %q", want)
+		return
+	}
+	speech := resp.Response.OutputSpeech
+	got := speech.Text
+	if speech.Type == "SSML" {
+		got = speech.SSML
+	}
+	if got != want {
+		t.Fatalf("alexatest: outputSpeech = %q, want %q", got, want)
+	}
+}
+
+// AssertHasDirective fails the test unless resp contains a directive whose "type" field equals
+// directiveType, e.g. AssertHasDirective(t, resp, "AudioPlayer.Play").
+func AssertHasDirective(t *testing.T, resp *alexa.ResponseEnvelope, directiveType string) {
+	t.Helper()
+	if resp == nil || resp.Response == nil {
+		t.Fatalf("alexatest: response has no directives, want %q", directiveType)
+		return
+	}
+	for _, directive := range resp.Response.Directives {
+		if directiveTypeOf(directive) == directiveType {
+			return
+		}
+	}
+	t.Fatalf("alexatest: response does not contain a %q directive", directiveType)
+}
+
+// AssertSessionEnded fails the test unless resp's shouldEndSession equals want.
+func AssertSessionEnded(t *testing.T, resp *alexa.ResponseEnvelope, want bool) {
+	t.Helper()
+	if resp == nil || resp.Response == nil || resp.Response.ShouldEndSession == nil {
+		t.Fatalf("alexatest: response does not set shouldEndSession, want %v", want)
+		return
+	}
+	if got := *resp.Response.ShouldEndSession; got != want {
+		t.Fatalf("alexatest: shouldEndSession = %v, want %v", got, want)
+	}
+}
+
+// AssertSlotElicited fails the test unless resp contains a Dialog.ElicitSlot directive for slotName.
+func AssertSlotElicited(t *testing.T, resp *alexa.ResponseEnvelope, slotName string) {
+	t.Helper()
+	if resp == nil || resp.Response == nil {
+		t.Fatalf("alexatest: response does not elicit slot %q", slotName)
+		return
+	}
+	for _, directive := range resp.Response.Directives {
+		elicit, ok := directive.(*alexa.DialogElicitSlotDirective)
+		if ok && elicit.SlotToElicit == slotName {
+			return
+		}
+	}
+	t.Fatalf("alexatest: response does not elicit slot %q", slotName)
+}
+
+// directiveTypeOf extracts a directive's "type" field regardless of its concrete Go type.
+func directiveTypeOf(directive interface{}) string {
+	data, err := json.Marshal(directive)
+	if err != nil {
+		return ""
+	}
+	var decoded struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return ""
+	}
+	return decoded.Type
+}
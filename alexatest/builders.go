@@ -0,0 +1,147 @@
+// Package alexatest provides request builders and response assertions for testing alexa
+// skills built on top of the alexa package, without needing to run them against AWS Lambda.
+package alexatest
+
+import (
+	"time"
+
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexa"
+)
+
+const (
+	testApplicationID  = "amzn1.ask.skill.test-application-id"
+	testSessionID      = "amzn1.echo-api.session.test-session-id"
+	testUserID         = "amzn1.ask.account.test-user-id"
+	testDeviceID       = "amzn1.ask.device.test-device-id"
+	testAPIAccessToken = "test-api-access-token"
+	testAPIEndpoint    = "https://api.amazonalexa.com"
+	testRequestID      = "amzn1.echo-api.request.test-request-id"
+	testLocale         = "en-US"
+)
+
+// newEnvelope builds a RequestEnvelope with synthetic Session, System, Device and
+// ApiAccessToken values, ready to be decorated with a concrete request.
+func newEnvelope() *alexa.RequestEnvelope {
+	return &alexa.RequestEnvelope{
+		Version: "1.0",
+		Session: alexa.Session{
+			New:         true,
+			SessionID:   testSessionID,
+			Attributes:  map[string]interface{}{},
+			Application: alexa.Application{ApplicationID: testApplicationID},
+			User:        alexa.User{UserID: testUserID},
+		},
+		Context: alexa.Context{
+			System: alexa.System{
+				APIAccessToken: testAPIAccessToken,
+				APIEndpoint:    testAPIEndpoint,
+				Application:    alexa.Application{ApplicationID: testApplicationID},
+				Device:         alexa.Device{DeviceID: testDeviceID, SupportedInterfaces: map[string]interface{}{}},
+				User:           alexa.User{UserID: testUserID},
+			},
+		},
+	}
+}
+
+// timestamp returns the current time formatted the way alexa requests carry it, so builders
+// always produce requests that pass Skill's timestamp freshness check.
+func timestamp() string {
+	return time.Now().UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// NewLaunchRequest builds a fully-populated LaunchRequest envelope for the given locale.
+func NewLaunchRequest(locale string) *alexa.RequestEnvelope {
+	envelope := newEnvelope()
+	envelope.Request = map[string]interface{}{
+		"type":      "LaunchRequest",
+		"requestId": testRequestID,
+		"timestamp": timestamp(),
+		"locale":    locale,
+	}
+	return envelope
+}
+
+// NewSessionEndedRequest builds a fully-populated SessionEndedRequest envelope with the given reason.
+func NewSessionEndedRequest(reason string) *alexa.RequestEnvelope {
+	envelope := newEnvelope()
+	envelope.Request = map[string]interface{}{
+		"type":      "SessionEndedRequest",
+		"requestId": testRequestID,
+		"timestamp": timestamp(),
+		"locale":    testLocale,
+		"reason":    reason,
+	}
+	return envelope
+}
+
+// NewAudioPlayerRequest builds a fully-populated envelope for an AudioPlayer event, e.g.
+// NewAudioPlayerRequest("AudioPlayer.PlaybackStarted", token, offset).
+func NewAudioPlayerRequest(requestType, token string, offsetInMilliseconds int) *alexa.RequestEnvelope {
+	envelope := newEnvelope()
+	envelope.Request = map[string]interface{}{
+		"type":                 requestType,
+		"requestId":            testRequestID,
+		"timestamp":            timestamp(),
+		"locale":               testLocale,
+		"token":                token,
+		"offsetInMilliseconds": offsetInMilliseconds,
+	}
+	return envelope
+}
+
+// IntentRequestBuilder incrementally builds a fully-populated IntentRequest envelope. Embedding
+// *alexa.RequestEnvelope lets callers reach it as builder.RequestEnvelope once they're done
+// adding slots and resolutions.
+type IntentRequestBuilder struct {
+	*alexa.RequestEnvelope
+	slots    map[string]interface{}
+	lastSlot string
+}
+
+// NewIntentRequest starts building an IntentRequest envelope for the named intent.
+func NewIntentRequest(name string) *IntentRequestBuilder {
+	envelope := newEnvelope()
+	slots := map[string]interface{}{}
+	envelope.Request = map[string]interface{}{
+		"type":      "IntentRequest",
+		"requestId": testRequestID,
+		"timestamp": timestamp(),
+		"locale":    testLocale,
+		"intent": map[string]interface{}{
+			"name":  name,
+			"slots": slots,
+		},
+	}
+	return &IntentRequestBuilder{RequestEnvelope: envelope, slots: slots}
+}
+
+// WithSlot adds a slot with the given name and value to the intent. Subsequent WithResolution
+// calls attach resolutions to this slot, until the next WithSlot call.
+func (b *IntentRequestBuilder) WithSlot(name, value string) *IntentRequestBuilder {
+	b.slots[name] = map[string]interface{}{"name": name, "value": value}
+	b.lastSlot = name
+	return b
+}
+
+// WithResolution attaches an entity resolution from authority to the slot added by the most
+// recent WithSlot call, e.g. WithResolution("AMAZON.US_CITY", "ER_SUCCESS_MATCH", id, value).
+func (b *IntentRequestBuilder) WithResolution(authority, statusCode, id, value string) *IntentRequestBuilder {
+	slot, ok := b.slots[b.lastSlot].(map[string]interface{})
+	if !ok {
+		return b
+	}
+	resolutions, _ := slot["resolutions"].(map[string]interface{})
+	if resolutions == nil {
+		resolutions = map[string]interface{}{"resolutionsPerAuthority": []interface{}{}}
+		slot["resolutions"] = resolutions
+	}
+	perAuthority := resolutions["resolutionsPerAuthority"].([]interface{})
+	resolutions["resolutionsPerAuthority"] = append(perAuthority, map[string]interface{}{
+		"authority": authority,
+		"status":    map[string]interface{}{"code": statusCode},
+		"values": []interface{}{
+			map[string]interface{}{"value": map[string]interface{}{"id": id, "name": value}},
+		},
+	})
+	return b
+}
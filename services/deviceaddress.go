@@ -0,0 +1,89 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexa"
+)
+
+// Address is a device's full registered postal address, as returned by the Device Address API.
+type Address struct {
+	AddressLine1     string `json:"addressLine1"`
+	AddressLine2     string `json:"addressLine2"`
+	AddressLine3     string `json:"addressLine3"`
+	City             string `json:"city"`
+	StateOrRegion    string `json:"stateOrRegion"`
+	DistrictOrCounty string `json:"districtOrCounty"`
+	PostalCode       string `json:"postalCode"`
+	CountryCode      string `json:"countryCode"`
+}
+
+// CountryAndPostalCode is the reduced-precision address alexa returns to skills that only hold
+// the alexa::devices:all:address:country_and_postal_code:read permission.
+type CountryAndPostalCode struct {
+	CountryCode string `json:"countryCode"`
+	PostalCode  string `json:"postalCode"`
+}
+
+// DeviceAddressClient reads the postal address associated with an alexa device, if the user has
+// granted the skill permission to see it.
+type DeviceAddressClient struct {
+	apiEndpoint    string
+	apiAccessToken string
+	httpClient     *http.Client
+}
+
+// NewDeviceAddressClient builds a DeviceAddressClient from ctx's API endpoint and access token.
+// httpClient may be nil, in which case http.DefaultClient is used.
+func NewDeviceAddressClient(ctx *alexa.Context, httpClient *http.Client) *DeviceAddressClient {
+	return &DeviceAddressClient{
+		apiEndpoint:    ctx.System.APIEndpoint,
+		apiAccessToken: ctx.System.APIAccessToken,
+		httpClient:     defaultIfNil(httpClient),
+	}
+}
+
+// GetAddress returns the full postal address registered to deviceID.
+func (c *DeviceAddressClient) GetAddress(deviceID string) (*Address, error) {
+	var address Address
+	if err := c.get(fmt.Sprintf("/v1/devices/%s/settings/address", deviceID), &address); err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+// GetCountryAndPostalCode returns the reduced-precision address registered to deviceID.
+func (c *DeviceAddressClient) GetCountryAndPostalCode(deviceID string) (*CountryAndPostalCode, error) {
+	var address CountryAndPostalCode
+	if err := c.get(fmt.Sprintf("/v1/devices/%s/settings/address/countryAndPostalCode", deviceID), &address); err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+func (c *DeviceAddressClient) get(path string, out interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.apiEndpoint+path, nil)
+	if err != nil {
+		return fmt.Errorf("services: unable to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiAccessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("services: unable to fetch device address: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusForbidden:
+		return ErrPermissionNotGranted
+	case http.StatusNoContent:
+		return ErrDeviceAddressUnavailable
+	case http.StatusOK:
+		return json.NewDecoder(resp.Body).Decode(out)
+	default:
+		return fmt.Errorf("services: unexpected status fetching device address: %s", resp.Status)
+	}
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexa"
+)
+
+// ProgressiveResponseClient sends speech to alexa while a skill is still working on its final
+// response, so the user isn't left in silence during a slow lookup or external call.
+type ProgressiveResponseClient struct {
+	apiEndpoint    string
+	apiAccessToken string
+	httpClient     *http.Client
+}
+
+// NewProgressiveResponseClient builds a ProgressiveResponseClient from ctx's API endpoint and
+// access token. httpClient may be nil, in which case http.DefaultClient is used.
+func NewProgressiveResponseClient(ctx *alexa.Context, httpClient *http.Client) *ProgressiveResponseClient {
+	return &ProgressiveResponseClient{
+		apiEndpoint:    ctx.System.APIEndpoint,
+		apiAccessToken: ctx.System.APIAccessToken,
+		httpClient:     defaultIfNil(httpClient),
+	}
+}
+
+// SendSpeech asks alexa to speak speech to the user immediately, while the skill keeps working
+// on its final response to requestID.
+func (c *ProgressiveResponseClient) SendSpeech(requestID, speech string) error {
+	directive := map[string]interface{}{
+		"header": map[string]interface{}{
+			"requestId": requestID,
+		},
+		"directive": map[string]interface{}{
+			"type":   "VoicePlayer.Speak",
+			"speech": speech,
+		},
+	}
+	body, err := json.Marshal(directive)
+	if err != nil {
+		return fmt.Errorf("services: unable to encode directive: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.apiEndpoint+"/v1/directives", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("services: unable to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiAccessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("services: unable to send progressive response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		return ErrPermissionNotGranted
+	}
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("services: unexpected status sending progressive response: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// Package services provides small HTTP clients for the alexa service APIs unlocked by
+// Context.System.APIAccessToken and Context.System.APIEndpoint, such as progressive responses
+// and the device address and user profile permission-gated APIs.
+package services
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrPermissionNotGranted is returned when the user has not granted the skill the permission
+// required to call an API. Skills should prompt the user to grant it via a permissions card.
+var ErrPermissionNotGranted = errors.New("services: the user has not granted this permission")
+
+// ErrDeviceAddressUnavailable is returned by DeviceAddressClient when the permission was
+// granted but no address is currently associated with the device.
+var ErrDeviceAddressUnavailable = errors.New("services: no address is associated with this device")
+
+// defaultIfNil returns httpClient, falling back to http.DefaultClient when it is nil.
+func defaultIfNil(httpClient *http.Client) *http.Client {
+	if httpClient != nil {
+		return httpClient
+	}
+	return http.DefaultClient
+}
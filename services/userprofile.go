@@ -0,0 +1,83 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/fgoncalves/alexa-skills-kit-for-go/alexa"
+)
+
+// MobileNumber is the user's phone number, as returned by the Profile.mobileNumber API.
+type MobileNumber struct {
+	CountryCode string `json:"countryCode"`
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+// UserProfileClient reads profile information about the user running the skill, if they have
+// granted the skill permission to see it.
+type UserProfileClient struct {
+	apiEndpoint    string
+	apiAccessToken string
+	httpClient     *http.Client
+}
+
+// NewUserProfileClient builds a UserProfileClient from ctx's API endpoint and access token.
+// httpClient may be nil, in which case http.DefaultClient is used.
+func NewUserProfileClient(ctx *alexa.Context, httpClient *http.Client) *UserProfileClient {
+	return &UserProfileClient{
+		apiEndpoint:    ctx.System.APIEndpoint,
+		apiAccessToken: ctx.System.APIAccessToken,
+		httpClient:     defaultIfNil(httpClient),
+	}
+}
+
+// GetName returns the user's full name.
+func (c *UserProfileClient) GetName() (string, error) {
+	var name string
+	if err := c.get("/v2/accounts/~current/settings/Profile.name", &name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// GetEmail returns the user's email address.
+func (c *UserProfileClient) GetEmail() (string, error) {
+	var email string
+	if err := c.get("/v2/accounts/~current/settings/Profile.email", &email); err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// GetMobileNumber returns the user's phone number.
+func (c *UserProfileClient) GetMobileNumber() (*MobileNumber, error) {
+	var number MobileNumber
+	if err := c.get("/v2/accounts/~current/settings/Profile.mobileNumber", &number); err != nil {
+		return nil, err
+	}
+	return &number, nil
+}
+
+func (c *UserProfileClient) get(path string, out interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, c.apiEndpoint+path, nil)
+	if err != nil {
+		return fmt.Errorf("services: unable to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiAccessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("services: unable to fetch user profile: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusForbidden:
+		return ErrPermissionNotGranted
+	case http.StatusOK:
+		return json.NewDecoder(resp.Body).Decode(out)
+	default:
+		return fmt.Errorf("services: unexpected status fetching user profile: %s", resp.Status)
+	}
+}